@@ -3,12 +3,13 @@ package catalog
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 	networkingV1 "k8s.io/api/networking/v1"
-	networkingV1beta1 "k8s.io/api/networking/v1beta1"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/service"
@@ -28,6 +29,20 @@ const (
 	// It is used to guess whether a path specified appears as a regex.
 	// It is used as a fallback to match ingress paths whose PathType is set to be ImplementationSpecific.
 	commonRegexChars = `^$*+[]%|`
+
+	// legacyIngressClassAnnotation is the deprecated annotation used to associate an Ingress resource
+	// with an ingress controller prior to the networking.k8s.io/v1 IngressClass API.
+	// Ref: https://kubernetes.io/docs/concepts/services-networking/ingress/#deprecated-annotation
+	legacyIngressClassAnnotation = "kubernetes.io/ingress.class"
+
+	// useRegexAnnotation forces every path on an Ingress resource to be interpreted as a regex, regardless
+	// of its declared PathType. This replaces guessing whether an ImplementationSpecific path is a regex
+	// by scanning it for characters commonly used in one.
+	useRegexAnnotation = "openservicemesh.io/use-regex"
+
+	// rewriteTargetAnnotation attaches a rewrite to the HTTP routes generated for an Ingress resource, so
+	// the Envoy route emits a prefix_rewrite/regex_rewrite to the given target.
+	rewriteTargetAnnotation = "openservicemesh.io/rewrite-target"
 )
 
 // Ensure the regex pattern for prefix matching for path elements compiles
@@ -46,8 +61,124 @@ func (mc *MeshCatalog) GetIngressTrafficPolicy(svc service.MeshService) (*traffi
 
 // getIngressTrafficPolicy returns the ingress traffic policy for the given mesh service from corresponding IngressBackend resource
 func (mc *MeshCatalog) getIngressTrafficPolicy(svc service.MeshService) (*trafficpolicy.IngressTrafficPolicy, error) {
-	// TODO(#3779): build policy from IngressBackend
-	return nil, nil
+	ingressBackend := mc.policyController.GetIngressBackendPolicy(svc)
+	if ingressBackend == nil {
+		log.Trace().Msgf("No IngressBackend policy found for service %s", svc)
+		return nil, nil
+	}
+
+	protocolToPortMap, err := mc.GetTargetPortToProtocolMappingForService(svc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error retrieving port to protocol mapping for service %s", svc)
+	}
+
+	var trafficMatches []*trafficpolicy.IngressTrafficMatch
+	var httpRoutePolicies []*trafficpolicy.InboundTrafficPolicy
+
+	for _, backend := range ingressBackend.Spec.Backends {
+		if backend.Name != svc.Name {
+			continue
+		}
+
+		port := uint32(backend.Port.Number)
+		_, ok := protocolToPortMap[port]
+		if !ok {
+			log.Error().Msgf("Port %d for backend %s referenced in IngressBackend %s/%s does not correspond to a port on service %s",
+				port, backend.Name, ingressBackend.Namespace, ingressBackend.Name, svc)
+			continue
+		}
+
+		sourceIPRanges, authorizedPrincipals := mc.getIngressBackendSources(ingressBackend)
+
+		trafficMatch := &trafficpolicy.IngressTrafficMatch{
+			Port:                     port,
+			SkipClientCertValidation: backend.TLS.SkipClientCertValidation,
+			SourceIPRanges:           sourceIPRanges,
+			AuthorizedPrincipals:     authorizedPrincipals,
+		}
+
+		switch backend.Port.Protocol {
+		case constants.ProtocolHTTP:
+			trafficMatch.Name = fmt.Sprintf("ingress_%s_%d_%s", svc, port, constants.ProtocolHTTP)
+			trafficMatch.Protocol = constants.ProtocolHTTP
+
+		case constants.ProtocolHTTPS:
+			trafficMatch.Protocol = constants.ProtocolHTTPS
+
+			if len(backend.TLS.SNIHosts) == 0 {
+				trafficMatch.Name = fmt.Sprintf("ingress_%s_%d_%s", svc, port, constants.ProtocolHTTPS)
+				trafficMatches = append(trafficMatches, trafficMatch)
+				continue
+			}
+
+			for _, sniHost := range backend.TLS.SNIHosts {
+				sniMatch := *trafficMatch
+				sniMatch.Name = fmt.Sprintf("ingress_%s_%d_%s_%s", svc, port, constants.ProtocolHTTPS, sniHost)
+				sniMatch.ServerNames = []string{sniHost}
+				trafficMatches = append(trafficMatches, &sniMatch)
+			}
+			continue
+
+		case constants.ProtocolHTTPSWithMTLS:
+			trafficMatch.Name = fmt.Sprintf("ingress_%s_%d_%s", svc, port, constants.ProtocolHTTPSWithMTLS)
+			trafficMatch.Protocol = constants.ProtocolHTTPSWithMTLS
+
+		default:
+			log.Error().Msgf("Backend %s on IngressBackend %s/%s references unsupported protocol %s, ignoring",
+				backend.Name, ingressBackend.Namespace, ingressBackend.Name, backend.Port.Protocol)
+			continue
+		}
+
+		trafficMatches = append(trafficMatches, trafficMatch)
+	}
+
+	if len(trafficMatches) == 0 {
+		return nil, nil
+	}
+
+	httpRoutePolicies = getIngressBackendHTTPRoutePolicies(svc, ingressBackend)
+
+	return &trafficpolicy.IngressTrafficPolicy{
+		TrafficMatches:    trafficMatches,
+		HTTPRoutePolicies: httpRoutePolicies,
+	}, nil
+}
+
+// getIngressBackendSources derives the IP CIDR ranges and SPIFFE identities from the Sources[]
+// specified on the given IngressBackend so they can be authorized by the Envoy RBAC and SNI
+// filters configured for the corresponding IngressTrafficMatch.
+func (mc *MeshCatalog) getIngressBackendSources(ingressBackend *policyv1alpha1.IngressBackend) (sourceIPRanges []string, authorizedPrincipals []string) {
+	for _, source := range ingressBackend.Spec.Sources {
+		switch source.Kind {
+		case policyv1alpha1.KindIPRange:
+			sourceIPRanges = append(sourceIPRanges, source.Name)
+
+		case policyv1alpha1.KindAuthenticatedPrincipal:
+			authorizedPrincipals = append(authorizedPrincipals, source.Name)
+
+		case policyv1alpha1.KindService:
+			principal := identity.K8sServiceAccount{Name: source.Name, Namespace: source.Namespace}.ToServiceIdentity()
+			authorizedPrincipals = append(authorizedPrincipals, principal.AsPrincipal(mc.trustDomain))
+
+		default:
+			log.Error().Msgf("Unsupported Source kind %s on IngressBackend %s/%s, ignoring", source.Kind, ingressBackend.Namespace, ingressBackend.Name)
+		}
+	}
+
+	return sourceIPRanges, authorizedPrincipals
+}
+
+// getIngressBackendHTTPRoutePolicies builds the HTTP route policy for an IngressBackend resource: a single
+// wildcard route on the service that allows the wildcard service identity. Sources are not restricted at the
+// route level; they are carried on the IngressTrafficMatch built by getIngressTrafficPolicy.
+func getIngressBackendHTTPRoutePolicies(svc service.MeshService, ingressBackend *policyv1alpha1.IngressBackend) []*trafficpolicy.InboundTrafficPolicy {
+	ingressWeightedCluster := getDefaultWeightedClusterForService(svc)
+	ingressPolicy := trafficpolicy.NewInboundTrafficPolicy(
+		getIngressTrafficPolicyName(ingressBackend.ObjectMeta.Name, ingressBackend.ObjectMeta.Namespace, constants.WildcardHTTPMethod),
+		[]string{constants.WildcardHTTPMethod})
+	ingressPolicy.AddRule(*trafficpolicy.NewRouteWeightedCluster(trafficpolicy.WildCardRouteMatch, []service.WeightedCluster{ingressWeightedCluster}), identity.WildcardServiceIdentity)
+
+	return []*trafficpolicy.InboundTrafficPolicy{ingressPolicy}
 }
 
 // getIngressTrafficPolicyFromK8s returns the ingress traffic policy for the given mesh service from the corresponding k8s Ingress resource
@@ -68,7 +199,48 @@ func (mc *MeshCatalog) getIngressTrafficPolicyFromK8s(svc service.MeshService) (
 		return nil, errors.Wrapf(err, "Error retrieving port to protocol mapping for service %s", svc)
 	}
 
+	tlsHosts, err := mc.getIngressTLSHosts(svc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error retrieving ingress TLS configuration for service %s", svc)
+	}
+
+	coveredHosts := make(map[string]struct{}, len(tlsHosts))
+	for _, tlsHost := range tlsHosts {
+		coveredHosts[tlsHost.host] = struct{}{}
+	}
+
 	enableHTTPSIngress := mc.configurator.UseHTTPSIngress()
+
+	// A host is only served over plain HTTP if no Ingress Spec.TLS block claims it. When no Ingress declares
+	// Spec.TLS, every host is served over TLS if HTTPS ingress is enabled, and over plain HTTP otherwise.
+	hasUncoveredHost := len(tlsHosts) == 0 && !enableHTTPSIngress
+	if len(tlsHosts) > 0 {
+		for _, httpRoutePolicy := range httpRoutePolicies {
+			for _, host := range httpRoutePolicy.Hostnames {
+				if _, ok := coveredHosts[host]; !ok {
+					hasUncoveredHost = true
+				}
+			}
+		}
+	}
+
+	enableIngressMTLS := mc.configurator.UseIngressMTLS()
+	clientCABundle := mc.configurator.GetIngressMTLSClientCABundle()
+
+	trafficMatches := buildK8sIngressTrafficMatches(svc, protocolToPortMap, tlsHosts, hasUncoveredHost, enableHTTPSIngress, enableIngressMTLS, clientCABundle)
+
+	return &trafficpolicy.IngressTrafficPolicy{
+		TrafficMatches:    trafficMatches,
+		HTTPRoutePolicies: httpRoutePolicies,
+	}, nil
+}
+
+// buildK8sIngressTrafficMatches builds the IngressTrafficMatch(es) for a k8s-Ingress-derived
+// IngressTrafficPolicy: a TLS match per Spec.TLS host, a legacy fallback TLS match (with and without SNI)
+// when no host declares Spec.TLS but HTTPS ingress is enabled, and a plain-HTTP match when some host isn't
+// covered by TLS -- for every HTTP port the service exposes. It is a pure function of its inputs, separated
+// out of getIngressTrafficPolicyFromK8s so it can be unit tested without a MeshCatalog.
+func buildK8sIngressTrafficMatches(svc service.MeshService, protocolToPortMap map[uint32]string, tlsHosts []ingressTLSHost, hasUncoveredHost, enableHTTPSIngress, enableIngressMTLS bool, clientCABundle trafficpolicy.SecretReference) []*trafficpolicy.IngressTrafficMatch {
 	var trafficMatches []*trafficpolicy.IngressTrafficMatch
 	// Create protocol specific ingress filter chains per port to handle different ports serving different protocols
 	for port, appProtocol := range protocolToPortMap {
@@ -77,56 +249,112 @@ func (mc *MeshCatalog) getIngressTrafficPolicyFromK8s(svc service.MeshService) (
 			continue
 		}
 
-		trafficMatch := &trafficpolicy.IngressTrafficMatch{
-			Port: port,
+		// Emit a dedicated TLS traffic match, with the right cert wired in via TLSSecretRef, for every
+		// host claimed by an Ingress Spec.TLS block.
+		for _, tlsHost := range tlsHosts {
+			trafficMatch := &trafficpolicy.IngressTrafficMatch{
+				Name:                     fmt.Sprintf("ingress_%s_%d_%s_%s", svc, port, constants.ProtocolHTTPS, tlsHost.host),
+				Port:                     port,
+				Protocol:                 constants.ProtocolHTTPS,
+				ServerNames:              []string{tlsHost.host},
+				TLSSecretRef:             trafficpolicy.SecretReference{Name: tlsHost.secretName, Namespace: tlsHost.secretNamespace},
+				SkipClientCertValidation: true,
+			}
+
+			if enableIngressMTLS {
+				trafficMatch.SkipClientCertValidation = false
+				trafficMatch.ClientCABundleSecretRef = &clientCABundle
+			}
+
+			trafficMatches = append(trafficMatches, trafficMatch)
 		}
 
-		if enableHTTPSIngress {
-			// Configure 2 taffic matches for HTTPS ingress (TLS):
+		if len(tlsHosts) == 0 && enableHTTPSIngress {
+			// No Ingress resource specifies Spec.TLS for this service. Preserve the legacy behavior of
+			// terminating TLS for all hosts using the service's own certificate.
+			// Configure 2 traffic matches for HTTPS ingress (TLS):
 			// 1. Without SNI: to match clients that don't set the SNI
 			// 2. With SNI: to match clients that set the SNI
-
-			trafficMatch.Name = fmt.Sprintf("ingress_%s_%d_%s", svc, port, constants.ProtocolHTTPS)
-			trafficMatch.Protocol = constants.ProtocolHTTPS
-			trafficMatch.SkipClientCertValidation = true
+			trafficMatch := &trafficpolicy.IngressTrafficMatch{
+				Name:                     fmt.Sprintf("ingress_%s_%d_%s", svc, port, constants.ProtocolHTTPS),
+				Port:                     port,
+				Protocol:                 constants.ProtocolHTTPS,
+				SkipClientCertValidation: true,
+			}
 			trafficMatches = append(trafficMatches, trafficMatch)
 
 			trafficMatchWithSNI := *trafficMatch
 			trafficMatchWithSNI.Name = fmt.Sprintf("ingress_%s_%d_%s_with_sni", svc, port, constants.ProtocolHTTPS)
 			trafficMatchWithSNI.ServerNames = []string{svc.ServerName()}
 			trafficMatches = append(trafficMatches, &trafficMatchWithSNI)
-		} else {
-			trafficMatch.Name = fmt.Sprintf("ingress_%s_%d_%s", svc, port, constants.ProtocolHTTP)
-			trafficMatch.Protocol = constants.ProtocolHTTP
-			trafficMatches = append(trafficMatches, trafficMatch)
+		}
+
+		if hasUncoveredHost {
+			trafficMatches = append(trafficMatches, &trafficpolicy.IngressTrafficMatch{
+				Name:     fmt.Sprintf("ingress_%s_%d_%s", svc, port, constants.ProtocolHTTP),
+				Port:     port,
+				Protocol: constants.ProtocolHTTP,
+			})
 		}
 	}
 
-	return &trafficpolicy.IngressTrafficPolicy{
-		TrafficMatches:    trafficMatches,
-		HTTPRoutePolicies: httpRoutePolicies,
-	}, nil
+	return trafficMatches
 }
 
-// getIngressPoliciesFromK8s returns a list of inbound traffic policies for a service as defined in observed ingress k8s resources.
-func (mc *MeshCatalog) getIngressPoliciesFromK8s(svc service.MeshService) ([]*trafficpolicy.InboundTrafficPolicy, error) {
-	var inboundTrafficPolicies []*trafficpolicy.InboundTrafficPolicy
+// ingressTLSHost describes the TLS configuration specified via Spec.TLS on a k8s Ingress resource for a
+// single host.
+type ingressTLSHost struct {
+	host            string
+	secretName      string
+	secretNamespace string
+}
+
+// getIngressTLSHosts returns the set of hosts for which a k8s Ingress resource targeting the given service
+// declares a Spec.TLS block, along with the secret backing the certificate for that host.
+func (mc *MeshCatalog) getIngressTLSHosts(svc service.MeshService) ([]ingressTLSHost, error) {
+	ingresses, err := mc.getNormalizedIngresses(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	var tlsHosts []ingressTLSHost
+	for _, ingress := range ingresses {
+		for _, tls := range ingress.tls {
+			for _, host := range tls.hosts {
+				tlsHosts = append(tlsHosts, ingressTLSHost{host: host, secretName: tls.secretName, secretNamespace: ingress.namespace})
+			}
+		}
+	}
 
-	// Build policies for ingress v1
-	if v1Policies, err := mc.getIngressPoliciesNetworkingV1(svc); err != nil {
-		log.Error().Err(err).Msgf("Error building inbound ingress v1 inbound policies for service %s", svc)
-	} else {
-		inboundTrafficPolicies = append(inboundTrafficPolicies, v1Policies...)
+	return tlsHosts, nil
+}
+
+// getNormalizedIngresses returns the version-agnostic representation of every Ingress resource targeting
+// the given service that belongs to OSM's ingress controller.
+// Kubernetes 1.22 removed networking.k8s.io/v1beta1 Ingress, so networking.k8s.io/v1 is the only API
+// version consumed here.
+func (mc *MeshCatalog) getNormalizedIngresses(svc service.MeshService) ([]*normalizedIngress, error) {
+	ingresses, err := mc.ingressMonitor.GetIngressNetworkingV1(svc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error retrieving ingress resources for service %s", svc)
 	}
 
-	// Build policies for ingress v1beta1
-	if v1beta1Policies, err := mc.getIngressPoliciesNetworkingV1beta1(svc); err != nil {
-		log.Error().Err(err).Msgf("Error building inbound ingress v1beta inbound policies for service %s", svc)
-	} else {
-		inboundTrafficPolicies = append(inboundTrafficPolicies, v1beta1Policies...)
+	normalizedIngresses := make([]*normalizedIngress, 0, len(ingresses))
+	for _, ingress := range ingresses {
+		normalized := normalizeIngressV1(ingress)
+		if !mc.ingressClassBelongsToOSM(normalized.class, normalized.annotations) {
+			log.Trace().Msgf("Ingress %s/%s does not belong to OSM's ingress class, skipping", normalized.namespace, normalized.name)
+			continue
+		}
+		normalizedIngresses = append(normalizedIngresses, normalized)
 	}
 
-	return inboundTrafficPolicies, nil
+	return normalizedIngresses, nil
+}
+
+// getIngressPoliciesFromK8s returns a list of inbound traffic policies for a service as defined in observed ingress k8s resources.
+func (mc *MeshCatalog) getIngressPoliciesFromK8s(svc service.MeshService) ([]*trafficpolicy.InboundTrafficPolicy, error) {
+	return mc.getIngressPoliciesNetworkingV1(svc)
 }
 
 func getIngressTrafficPolicyName(name, namespace, host string) string {
@@ -134,203 +362,268 @@ func getIngressTrafficPolicyName(name, namespace, host string) string {
 	return policyName
 }
 
-// getIngressPoliciesNetworkingV1beta1 returns the list of inbound traffic policies associated with networking.k8s.io/v1beta1 ingress resources for the given service
-func (mc *MeshCatalog) getIngressPoliciesNetworkingV1beta1(svc service.MeshService) ([]*trafficpolicy.InboundTrafficPolicy, error) {
-	var inboundIngressPolicies []*trafficpolicy.InboundTrafficPolicy
+// ingressClassBelongsToOSM returns true if the given Ingress resource, identified by its IngressClassName
+// and legacy ingress.class annotation, is owned by OSM's ingress controller and should have policies
+// generated for it. This prevents OSM from generating bogus filter chains for ingresses belonging to
+// other ingress controllers (nginx, traefik, contour, etc.) running in the same cluster.
+func (mc *MeshCatalog) ingressClassBelongsToOSM(className string, annotations map[string]string) bool {
+	osmControllerName := mc.configurator.GetOSMIngressControllerName()
+
+	if className == "" {
+		// No IngressClassName was specified on the Ingress resource. Fall back, in order, to the legacy
+		// kubernetes.io/ingress.class annotation -- which carries the controller name directly rather
+		// than referencing an IngressClass resource -- and then to the cluster's default IngressClass,
+		// if one is marked as such.
+		if legacyClass, ok := annotations[legacyIngressClassAnnotation]; ok {
+			return legacyClass == osmControllerName
+		}
 
-	ingresses, err := mc.ingressMonitor.GetIngressNetworkingV1beta1(svc)
-	if err != nil {
-		log.Error().Err(err).Msgf("Failed to get ingress resources for service %s", svc)
-		return inboundIngressPolicies, err
+		defaultClass := mc.kubeController.GetDefaultIngressClass()
+		if defaultClass == nil {
+			return false
+		}
+		return defaultClass.Spec.Controller == osmControllerName
 	}
-	if len(ingresses) == 0 {
-		log.Trace().Msgf("No ingress resources found for service %s", svc)
-		return inboundIngressPolicies, err
+
+	ingressClass := mc.kubeController.GetIngressClass(className)
+	if ingressClass == nil {
+		log.Error().Msgf("IngressClassName %s referenced by an Ingress resource was not found", className)
+		return false
 	}
 
-	ingressWeightedCluster := getDefaultWeightedClusterForService(svc)
+	return ingressClass.Spec.Controller == osmControllerName
+}
 
-	for _, ingress := range ingresses {
-		if ingress.Spec.Backend != nil && ingress.Spec.Backend.ServiceName == svc.Name {
-			wildcardIngressPolicy := trafficpolicy.NewInboundTrafficPolicy(getIngressTrafficPolicyName(ingress.ObjectMeta.Name, ingress.ObjectMeta.Namespace, constants.WildcardHTTPMethod), []string{constants.WildcardHTTPMethod})
-			wildcardIngressPolicy.AddRule(*trafficpolicy.NewRouteWeightedCluster(trafficpolicy.WildCardRouteMatch, []service.WeightedCluster{ingressWeightedCluster}), identity.WildcardServiceIdentity)
-			inboundIngressPolicies = trafficpolicy.MergeInboundPolicies(DisallowPartialHostnamesMatch, inboundIngressPolicies, wildcardIngressPolicy)
+// ingressPathMatchTypePrecedence ranks a PathMatchType by specificity; a lower value is more specific.
+func ingressPathMatchTypePrecedence(t trafficpolicy.PathMatchType) int {
+	switch t {
+	case trafficpolicy.PathMatchExact:
+		return 0
+	case trafficpolicy.PathMatchRegex:
+		return 1
+	case trafficpolicy.PathMatchPrefix:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// hostSpecificity ranks a host by specificity; a lower value is more specific. An exact host is more
+// specific than a "*.suffix" wildcard, which is in turn more specific than the bare "*" wildcard.
+func hostSpecificity(host string) int {
+	switch {
+	case host == constants.WildcardHTTPMethod:
+		return 2
+	case strings.HasPrefix(host, "*."):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// mostSpecificHost returns the most specific of the given hostnames, as ranked by hostSpecificity.
+func mostSpecificHost(hostnames []string) string {
+	var best string
+	bestRank := -1
+	for _, host := range hostnames {
+		if rank := hostSpecificity(host); bestRank == -1 || rank < bestRank {
+			bestRank = rank
+			best = host
 		}
+	}
+	return best
+}
 
-		for _, rule := range ingress.Spec.Rules {
-			domain := rule.Host
-			if domain == "" {
-				domain = constants.WildcardHTTPMethod
-			}
-			ingressPolicy := trafficpolicy.NewInboundTrafficPolicy(getIngressTrafficPolicyName(ingress.ObjectMeta.Name, ingress.ObjectMeta.Namespace, domain), []string{domain})
+// ingressRuleOrigin identifies the Ingress object that contributed a given HTTP route rule. It is
+// recorded at rule-construction time, keyed by rule pointer, because MergeInboundPolicies folds the
+// Rules of same-host policies from multiple Ingress objects together and does not itself preserve which
+// Ingress a rule came from.
+type ingressRuleOrigin struct {
+	namespace string
+	name      string
+}
 
-			for _, ingressPath := range rule.HTTP.Paths {
-				if ingressPath.Backend.ServiceName != svc.Name {
-					continue
-				}
+// compareIngressRoutes orders two ingress-derived HTTP route rules by specificity, so that Envoy -- which
+// evaluates routes top to bottom -- checks the more specific route first. Without this a "/" wildcard path
+// defined in one Ingress object could mask an exact "/healthz" path defined in another.
+//
+// Rules are compared by: (1) PathMatchType precedence (Exact > Regex > Prefix), (2) path length
+// (descending), (3) number of HTTP methods (ascending, i.e. more specific first), (4) host specificity
+// of the owning policy, and finally (5) the contributing ingress's (namespace, name), as looked up in
+// origins, as a stable tiebreaker.
+//
+// Exported indirectly via sortIngressPoliciesBySpecificity so tests can assert on the exact ordering.
+func compareIngressRoutes(a, b *trafficpolicy.Rule, aPolicy, bPolicy *trafficpolicy.InboundTrafficPolicy, origins map[*trafficpolicy.Rule]ingressRuleOrigin) bool {
+	if aType, bType := ingressPathMatchTypePrecedence(a.Route.HTTPRouteMatch.PathMatchType), ingressPathMatchTypePrecedence(b.Route.HTTPRouteMatch.PathMatchType); aType != bType {
+		return aType < bType
+	}
 
-				httpRouteMatch := trafficpolicy.HTTPRouteMatch{
-					Methods: []string{constants.WildcardHTTPMethod},
-				}
+	if aLen, bLen := len(a.Route.HTTPRouteMatch.Path), len(b.Route.HTTPRouteMatch.Path); aLen != bLen {
+		return aLen > bLen
+	}
 
-				// Default ingress path type to PathTypeImplementationSpecific if unspecified
-				pathType := networkingV1beta1.PathTypeImplementationSpecific
-				if ingressPath.PathType != nil {
-					pathType = *ingressPath.PathType
-				}
+	if aMethods, bMethods := len(a.Route.HTTPRouteMatch.Methods), len(b.Route.HTTPRouteMatch.Methods); aMethods != bMethods {
+		return aMethods < bMethods
+	}
 
-				switch pathType {
-				case networkingV1beta1.PathTypeExact:
-					// Exact match
-					// Request /foo matches path /foo, not /foobar or /foo/bar
-					httpRouteMatch.Path = ingressPath.Path
-					httpRouteMatch.PathMatchType = trafficpolicy.PathMatchExact
-
-				case networkingV1beta1.PathTypePrefix:
-					// Element wise prefix match
-					// Request /foo matches path /foo and /foo/bar, not /foobar
-					if ingressPath.Path == "/" {
-						// A wildcard path '/' for Prefix pathType must be matched
-						// as a string based prefix match, ie. path '/' should
-						// match any path in the request.
-						httpRouteMatch.Path = ingressPath.Path
-						httpRouteMatch.PathMatchType = trafficpolicy.PathMatchPrefix
-					} else {
-						// Non-wildcard path of the form '/path' must be matched as a
-						// regex match to meet k8s Ingress API requirement of element-wise
-						// prefix matching.
-						// There is also the requirement for prefix /foo/ to match /foo
-						// based on k8s API interpretation of element-wise matching, so
-						// account for this case by trimming trailing '/'.
-						path := strings.TrimRight(ingressPath.Path, "/")
-						httpRouteMatch.Path = path + prefixMatchPathElementsRegex
-						httpRouteMatch.PathMatchType = trafficpolicy.PathMatchRegex
-					}
-
-				case networkingV1beta1.PathTypeImplementationSpecific:
-					httpRouteMatch.Path = ingressPath.Path
-					// If the path looks like a regex, use regex matching.
-					// Else use string based prefix matching.
-					if strings.ContainsAny(ingressPath.Path, commonRegexChars) {
-						// Path contains regex characters, use regex matching for the path
-						// Request /foo/bar matches path /foo.*
-						httpRouteMatch.PathMatchType = trafficpolicy.PathMatchRegex
-					} else {
-						// String based prefix path matching
-						// Request /foo matches /foo/bar and /foobar
-						httpRouteMatch.PathMatchType = trafficpolicy.PathMatchPrefix
-					}
-
-				default:
-					log.Error().Msgf("Invalid pathType=%s unspecified for path %s in ingress resource %s/%s, ignoring this path", *ingressPath.PathType, ingressPath.Path, ingress.Namespace, ingress.Name)
-					continue
-				}
+	if aHostRank, bHostRank := hostSpecificity(mostSpecificHost(aPolicy.Hostnames)), hostSpecificity(mostSpecificHost(bPolicy.Hostnames)); aHostRank != bHostRank {
+		return aHostRank < bHostRank
+	}
 
-				ingressPolicy.AddRule(*trafficpolicy.NewRouteWeightedCluster(httpRouteMatch, []service.WeightedCluster{ingressWeightedCluster}), identity.WildcardServiceIdentity)
-			}
+	aOrigin, bOrigin := origins[a], origins[b]
+	if aOrigin.namespace != bOrigin.namespace {
+		return aOrigin.namespace < bOrigin.namespace
+	}
+	return aOrigin.name < bOrigin.name
+}
 
-			// Only create an ingress policy if the ingress policy resulted in valid rules
-			if len(ingressPolicy.Rules) > 0 {
-				inboundIngressPolicies = trafficpolicy.MergeInboundPolicies(DisallowPartialHostnamesMatch, inboundIngressPolicies, ingressPolicy)
-			}
+// sortIngressPoliciesBySpecificity sorts the rules within each InboundTrafficPolicy, and the policies
+// themselves, from most to least specific using compareIngressRoutes. It is run as a post-processing step
+// after building ingress-derived policies because MergeInboundPolicies, which runs as rules from multiple
+// Ingress objects are folded together, does not otherwise preserve specificity ordering. origins maps
+// each rule back to the Ingress object that contributed it, so that ties can still be broken
+// deterministically by ingress identity after rules from different Ingress objects have been merged into
+// the same host's policy.
+func sortIngressPoliciesBySpecificity(policies []*trafficpolicy.InboundTrafficPolicy, origins map[*trafficpolicy.Rule]ingressRuleOrigin) {
+	for _, policy := range policies {
+		p := policy
+		sort.SliceStable(p.Rules, func(i, j int) bool {
+			return compareIngressRoutes(p.Rules[i], p.Rules[j], p, p, origins)
+		})
+	}
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		if len(policies[i].Rules) == 0 || len(policies[j].Rules) == 0 {
+			return len(policies[i].Rules) > len(policies[j].Rules)
 		}
+		return compareIngressRoutes(policies[i].Rules[0], policies[j].Rules[0], policies[i], policies[j], origins)
+	})
+}
+
+// buildIngressHTTPRouteMatch builds the HTTPRouteMatch for a single Ingress path, applying the
+// useRegexAnnotation and rewriteTargetAnnotation overrides on top of the path's declared PathType. The
+// returned bool is false when the path should be skipped entirely (an unsupported/invalid PathType), in
+// which case the HTTPRouteMatch is the zero value. It is a pure function of its inputs, separated out of
+// getIngressPoliciesNetworkingV1 so it can be unit tested without a MeshCatalog.
+func buildIngressHTTPRouteMatch(path string, pathType networkingV1.PathType, useRegex bool, rewriteTarget string, ingressNamespace, ingressName string) (trafficpolicy.HTTPRouteMatch, bool) {
+	httpRouteMatch := trafficpolicy.HTTPRouteMatch{
+		Methods: []string{constants.WildcardHTTPMethod},
 	}
-	return inboundIngressPolicies, nil
+
+	switch {
+	case useRegex:
+		// The openservicemesh.io/use-regex annotation forces every path on this ingress to
+		// be interpreted as a regex, overriding both the PathType dispatch below and the
+		// commonRegexChars heuristic used for PathTypeImplementationSpecific.
+		httpRouteMatch.Path = path
+		httpRouteMatch.PathMatchType = trafficpolicy.PathMatchRegex
+
+	case pathType == networkingV1.PathTypeExact:
+		// Exact match
+		// Request /foo matches path /foo, not /foobar or /foo/bar
+		httpRouteMatch.Path = path
+		httpRouteMatch.PathMatchType = trafficpolicy.PathMatchExact
+
+	case pathType == networkingV1.PathTypePrefix:
+		// Element wise prefix match
+		// Request /foo matches path /foo and /foo/bar, not /foobar
+		if path == "/" {
+			// A wildcard path '/' for Prefix pathType must be matched
+			// as a string based prefix match, ie. path '/' should
+			// match any path in the request.
+			httpRouteMatch.Path = path
+			httpRouteMatch.PathMatchType = trafficpolicy.PathMatchPrefix
+		} else {
+			// Non-wildcard path of the form '/path' must be matched as a
+			// regex match to meet k8s Ingress API requirement of element-wise
+			// prefix matching.
+			// There is also the requirement for prefix /foo/ to match /foo
+			// based on k8s API interpretation of element-wise matching, so
+			// account for this case by trimming trailing '/'.
+			trimmed := strings.TrimRight(path, "/")
+			httpRouteMatch.Path = trimmed + prefixMatchPathElementsRegex
+			httpRouteMatch.PathMatchType = trafficpolicy.PathMatchRegex
+		}
+
+	case pathType == networkingV1.PathTypeImplementationSpecific:
+		httpRouteMatch.Path = path
+		// If the path looks like a regex, use regex matching.
+		// Else use string based prefix matching.
+		if strings.ContainsAny(path, commonRegexChars) {
+			// Path contains regex characters, use regex matching for the path
+			// Request /foo/bar matches path /foo.*
+			httpRouteMatch.PathMatchType = trafficpolicy.PathMatchRegex
+		} else {
+			// String based prefix path matching
+			// Request /foo matches /foo/bar and /foobar
+			httpRouteMatch.PathMatchType = trafficpolicy.PathMatchPrefix
+		}
+
+	default:
+		log.Error().Msgf("Invalid pathType=%s for path %s in ingress resource %s/%s, ignoring this path", pathType, path, ingressNamespace, ingressName)
+		return trafficpolicy.HTTPRouteMatch{}, false
+	}
+
+	if rewriteTarget != "" {
+		if httpRouteMatch.PathMatchType == trafficpolicy.PathMatchExact {
+			log.Error().Msgf("%s cannot be combined with an Exact PathType, ignoring rewrite for path %s in ingress resource %s/%s",
+				rewriteTargetAnnotation, path, ingressNamespace, ingressName)
+		} else {
+			httpRouteMatch.RewriteTarget = rewriteTarget
+		}
+	}
+
+	return httpRouteMatch, true
 }
 
-// getIngressPoliciesNetworkingV1 returns the list of inbound traffic policies associated with networking.k8s.io/v1 ingress resources for the given service
+// getIngressPoliciesNetworkingV1 returns the list of inbound traffic policies built from the
+// version-agnostic representation of networking.k8s.io/v1 Ingress resources for the given service.
 func (mc *MeshCatalog) getIngressPoliciesNetworkingV1(svc service.MeshService) ([]*trafficpolicy.InboundTrafficPolicy, error) {
 	var inboundIngressPolicies []*trafficpolicy.InboundTrafficPolicy
 
-	ingresses, err := mc.ingressMonitor.GetIngressNetworkingV1(svc)
+	ingresses, err := mc.getNormalizedIngresses(svc)
 	if err != nil {
-		log.Error().Err(err).Msgf("Failed to get ingress resources for service %s", svc)
-		return inboundIngressPolicies, err
+		return nil, err
 	}
 	if len(ingresses) == 0 {
 		log.Trace().Msgf("No ingress resources found for service %s", svc)
-		return inboundIngressPolicies, err
+		return inboundIngressPolicies, nil
 	}
 
 	ingressWeightedCluster := getDefaultWeightedClusterForService(svc)
+	ruleOrigins := make(map[*trafficpolicy.Rule]ingressRuleOrigin)
 
 	for _, ingress := range ingresses {
-		if ingress.Spec.DefaultBackend != nil && ingress.Spec.DefaultBackend.Service.Name == svc.Name {
-			wildcardIngressPolicy := trafficpolicy.NewInboundTrafficPolicy(getIngressTrafficPolicyName(ingress.ObjectMeta.Name, ingress.ObjectMeta.Namespace, constants.WildcardHTTPMethod), []string{constants.WildcardHTTPMethod})
+		// Annotations are read once per ingress and threaded into the path type dispatch below.
+		useRegex := ingress.annotations[useRegexAnnotation] == "true"
+		rewriteTarget := ingress.annotations[rewriteTargetAnnotation]
+
+		if ingress.defaultBackend != nil && ingress.defaultBackend.serviceName == svc.Name {
+			wildcardIngressPolicy := trafficpolicy.NewInboundTrafficPolicy(getIngressTrafficPolicyName(ingress.name, ingress.namespace, constants.WildcardHTTPMethod), []string{constants.WildcardHTTPMethod})
 			wildcardIngressPolicy.AddRule(*trafficpolicy.NewRouteWeightedCluster(trafficpolicy.WildCardRouteMatch, []service.WeightedCluster{ingressWeightedCluster}), identity.WildcardServiceIdentity)
+			ruleOrigins[wildcardIngressPolicy.Rules[len(wildcardIngressPolicy.Rules)-1]] = ingressRuleOrigin{namespace: ingress.namespace, name: ingress.name}
 			inboundIngressPolicies = trafficpolicy.MergeInboundPolicies(DisallowPartialHostnamesMatch, inboundIngressPolicies, wildcardIngressPolicy)
 		}
 
-		for _, rule := range ingress.Spec.Rules {
-			domain := rule.Host
+		for _, rule := range ingress.rules {
+			domain := rule.host
 			if domain == "" {
 				domain = constants.WildcardHTTPMethod
 			}
-			ingressPolicy := trafficpolicy.NewInboundTrafficPolicy(getIngressTrafficPolicyName(ingress.ObjectMeta.Name, ingress.ObjectMeta.Namespace, domain), []string{domain})
+			ingressPolicy := trafficpolicy.NewInboundTrafficPolicy(getIngressTrafficPolicyName(ingress.name, ingress.namespace, domain), []string{domain})
 
-			for _, ingressPath := range rule.HTTP.Paths {
-				if ingressPath.Backend.Service.Name != svc.Name {
+			for _, ingressPath := range rule.paths {
+				if ingressPath.backend.serviceName != svc.Name {
 					continue
 				}
 
-				httpRouteMatch := trafficpolicy.HTTPRouteMatch{
-					Methods: []string{constants.WildcardHTTPMethod},
-				}
-
-				// Default ingress path type to PathTypeImplementationSpecific if unspecified
-				pathType := networkingV1.PathTypeImplementationSpecific
-				if ingressPath.PathType != nil {
-					pathType = *ingressPath.PathType
-				}
-
-				switch pathType {
-				case networkingV1.PathTypeExact:
-					// Exact match
-					// Request /foo matches path /foo, not /foobar or /foo/bar
-					httpRouteMatch.Path = ingressPath.Path
-					httpRouteMatch.PathMatchType = trafficpolicy.PathMatchExact
-
-				case networkingV1.PathTypePrefix:
-					// Element wise prefix match
-					// Request /foo matches path /foo and /foo/bar, not /foobar
-					if ingressPath.Path == "/" {
-						// A wildcard path '/' for Prefix pathType must be matched
-						// as a string based prefix match, ie. path '/' should
-						// match any path in the request.
-						httpRouteMatch.Path = ingressPath.Path
-						httpRouteMatch.PathMatchType = trafficpolicy.PathMatchPrefix
-					} else {
-						// Non-wildcard path of the form '/path' must be matched as a
-						// regex match to meet k8s Ingress API requirement of element-wise
-						// prefix matching.
-						// There is also the requirement for prefix /foo/ to match /foo
-						// based on k8s API interpretation of element-wise matching, so
-						// account for this case by trimming trailing '/'.
-						path := strings.TrimRight(ingressPath.Path, "/")
-						httpRouteMatch.Path = path + prefixMatchPathElementsRegex
-						httpRouteMatch.PathMatchType = trafficpolicy.PathMatchRegex
-					}
-
-				case networkingV1.PathTypeImplementationSpecific:
-					httpRouteMatch.Path = ingressPath.Path
-					// If the path looks like a regex, use regex matching.
-					// Else use string based prefix matching.
-					if strings.ContainsAny(ingressPath.Path, commonRegexChars) {
-						// Path contains regex characters, use regex matching for the path
-						// Request /foo/bar matches path /foo.*
-						httpRouteMatch.PathMatchType = trafficpolicy.PathMatchRegex
-					} else {
-						// String based prefix path matching
-						// Request /foo matches /foo/bar and /foobar
-						httpRouteMatch.PathMatchType = trafficpolicy.PathMatchPrefix
-					}
-
-				default:
-					log.Error().Msgf("Invalid pathType=%s unspecified for path %s in ingress resource %s/%s, ignoring this path", *ingressPath.PathType, ingressPath.Path, ingress.Namespace, ingress.Name)
+				httpRouteMatch, ok := buildIngressHTTPRouteMatch(ingressPath.path, ingressPath.pathType, useRegex, rewriteTarget, ingress.namespace, ingress.name)
+				if !ok {
 					continue
 				}
 
 				ingressPolicy.AddRule(*trafficpolicy.NewRouteWeightedCluster(httpRouteMatch, []service.WeightedCluster{ingressWeightedCluster}), identity.WildcardServiceIdentity)
+				ruleOrigins[ingressPolicy.Rules[len(ingressPolicy.Rules)-1]] = ingressRuleOrigin{namespace: ingress.namespace, name: ingress.name}
 			}
 
 			// Only create an ingress policy if the ingress policy resulted in valid rules
@@ -339,5 +632,7 @@ func (mc *MeshCatalog) getIngressPoliciesNetworkingV1(svc service.MeshService) (
 			}
 		}
 	}
+
+	sortIngressPoliciesBySpecificity(inboundIngressPolicies, ruleOrigins)
 	return inboundIngressPolicies, nil
 }
@@ -0,0 +1,72 @@
+package catalog
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestBuildK8sIngressTrafficMatches(t *testing.T) {
+	svc := service.MeshService{Name: "bookstore", Namespace: "default"}
+	clientCABundle := trafficpolicy.SecretReference{Name: "ca-bundle", Namespace: "default"}
+
+	t.Run("non-HTTP ports are skipped", func(t *testing.T) {
+		protocolToPortMap := map[uint32]string{80: "tcp"}
+		matches := buildK8sIngressTrafficMatches(svc, protocolToPortMap, nil, true, false, false, clientCABundle)
+		tassert.Empty(t, matches)
+	})
+
+	t.Run("a TLS host produces a TLS match wired to its own secret", func(t *testing.T) {
+		protocolToPortMap := map[uint32]string{80: constants.ProtocolHTTP}
+		tlsHosts := []ingressTLSHost{{host: "example.com", secretName: "example-com-cert", secretNamespace: "default"}}
+
+		matches := buildK8sIngressTrafficMatches(svc, protocolToPortMap, tlsHosts, false, false, false, clientCABundle)
+
+		tassert.Len(t, matches, 1)
+		tassert.Equal(t, constants.ProtocolHTTPS, matches[0].Protocol)
+		tassert.Equal(t, []string{"example.com"}, matches[0].ServerNames)
+		tassert.Equal(t, trafficpolicy.SecretReference{Name: "example-com-cert", Namespace: "default"}, matches[0].TLSSecretRef)
+		tassert.True(t, matches[0].SkipClientCertValidation)
+	})
+
+	t.Run("mTLS clears SkipClientCertValidation and wires the CA bundle", func(t *testing.T) {
+		protocolToPortMap := map[uint32]string{80: constants.ProtocolHTTP}
+		tlsHosts := []ingressTLSHost{{host: "example.com", secretName: "example-com-cert", secretNamespace: "default"}}
+
+		matches := buildK8sIngressTrafficMatches(svc, protocolToPortMap, tlsHosts, false, false, true, clientCABundle)
+
+		tassert.Len(t, matches, 1)
+		tassert.False(t, matches[0].SkipClientCertValidation)
+		tassert.Equal(t, &clientCABundle, matches[0].ClientCABundleSecretRef)
+	})
+
+	t.Run("no TLS hosts but HTTPS ingress enabled falls back to 2 legacy matches", func(t *testing.T) {
+		protocolToPortMap := map[uint32]string{80: constants.ProtocolHTTP}
+
+		matches := buildK8sIngressTrafficMatches(svc, protocolToPortMap, nil, false, true, false, clientCABundle)
+
+		tassert.Len(t, matches, 2)
+		tassert.Empty(t, matches[0].ServerNames)
+		tassert.Equal(t, []string{svc.ServerName()}, matches[1].ServerNames)
+	})
+
+	t.Run("an uncovered host adds a plain HTTP match", func(t *testing.T) {
+		protocolToPortMap := map[uint32]string{80: constants.ProtocolHTTP}
+		tlsHosts := []ingressTLSHost{{host: "example.com", secretName: "example-com-cert", secretNamespace: "default"}}
+
+		matches := buildK8sIngressTrafficMatches(svc, protocolToPortMap, tlsHosts, true, false, false, clientCABundle)
+
+		tassert.Len(t, matches, 2)
+		var sawHTTP bool
+		for _, m := range matches {
+			if m.Protocol == constants.ProtocolHTTP {
+				sawHTTP = true
+			}
+		}
+		tassert.True(t, sawHTTP, "expected a plain HTTP match for the uncovered host")
+	})
+}
@@ -0,0 +1,94 @@
+package catalog
+
+import (
+	networkingV1 "k8s.io/api/networking/v1"
+)
+
+// normalizedBackend is a version-agnostic reference to the Kubernetes Service backing an Ingress path or
+// the Ingress resource's default backend.
+type normalizedBackend struct {
+	serviceName string
+	port        int32
+}
+
+// normalizedPathMatch is a version-agnostic representation of a single HTTP path match within an Ingress rule.
+type normalizedPathMatch struct {
+	path     string
+	pathType networkingV1.PathType
+	backend  normalizedBackend
+}
+
+// normalizedRule is a version-agnostic representation of a single host rule within an Ingress resource.
+type normalizedRule struct {
+	host  string
+	paths []normalizedPathMatch
+}
+
+// normalizedTLS is a version-agnostic representation of a single Spec.TLS entry on an Ingress resource.
+type normalizedTLS struct {
+	hosts      []string
+	secretName string
+}
+
+// normalizedIngress is a version-agnostic internal representation of a Kubernetes Ingress resource. It is
+// built once per Ingress resource, so that the policy builders in this package are written -- and tested --
+// against a single shape rather than duplicating logic per version. Only networking.k8s.io/v1 Ingress
+// resources are converted into it, by normalizeIngressV1.
+type normalizedIngress struct {
+	name        string
+	namespace   string
+	annotations map[string]string
+	class       string
+
+	defaultBackend *normalizedBackend
+	rules          []normalizedRule
+	tls            []normalizedTLS
+}
+
+// normalizeIngressV1 converts a networking.k8s.io/v1 Ingress resource into the version-agnostic
+// normalizedIngress representation used by the ingress policy builders.
+func normalizeIngressV1(ingress *networkingV1.Ingress) *normalizedIngress {
+	n := &normalizedIngress{
+		name:        ingress.Name,
+		namespace:   ingress.Namespace,
+		annotations: ingress.Annotations,
+	}
+
+	if ingress.Spec.IngressClassName != nil {
+		n.class = *ingress.Spec.IngressClassName
+	}
+
+	if ingress.Spec.DefaultBackend != nil && ingress.Spec.DefaultBackend.Service != nil {
+		n.defaultBackend = &normalizedBackend{
+			serviceName: ingress.Spec.DefaultBackend.Service.Name,
+			port:        ingress.Spec.DefaultBackend.Service.Port.Number,
+		}
+	}
+
+	for _, tls := range ingress.Spec.TLS {
+		n.tls = append(n.tls, normalizedTLS{hosts: tls.Hosts, secretName: tls.SecretName})
+	}
+
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+
+		normalizedR := normalizedRule{host: rule.Host}
+		for _, path := range rule.HTTP.Paths {
+			pathType := networkingV1.PathTypeImplementationSpecific
+			if path.PathType != nil {
+				pathType = *path.PathType
+			}
+
+			normalizedR.paths = append(normalizedR.paths, normalizedPathMatch{
+				path:     path.Path,
+				pathType: pathType,
+				backend:  normalizedBackend{serviceName: path.Backend.Service.Name, port: path.Backend.Service.Port.Number},
+			})
+		}
+		n.rules = append(n.rules, normalizedR)
+	}
+
+	return n
+}
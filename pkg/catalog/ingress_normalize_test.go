@@ -0,0 +1,113 @@
+package catalog
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	networkingV1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNormalizeIngressV1(t *testing.T) {
+	exact := networkingV1.PathTypeExact
+
+	ingress := &networkingV1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "ingress-a",
+			Namespace:   "default",
+			Annotations: map[string]string{useRegexAnnotation: "true"},
+		},
+		Spec: networkingV1.IngressSpec{
+			IngressClassName: ptrTo("osm"),
+			DefaultBackend: &networkingV1.IngressBackend{
+				Service: &networkingV1.IngressServiceBackend{
+					Name: "default-backend",
+					Port: networkingV1.ServiceBackendPort{Number: 8080},
+				},
+			},
+			TLS: []networkingV1.IngressTLS{
+				{Hosts: []string{"example.com"}, SecretName: "example-com-cert"},
+			},
+			Rules: []networkingV1.IngressRule{
+				{
+					Host: "example.com",
+					IngressRuleValue: networkingV1.IngressRuleValue{
+						HTTP: &networkingV1.HTTPIngressRuleValue{
+							Paths: []networkingV1.HTTPIngressPath{
+								{
+									Path:     "/healthz",
+									PathType: &exact,
+									Backend: networkingV1.IngressBackend{
+										Service: &networkingV1.IngressServiceBackend{
+											Name: "bookstore",
+											Port: networkingV1.ServiceBackendPort{Number: 80},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					// A rule with no HTTP block must be skipped rather than producing an empty normalizedRule.
+					Host: "no-http.example.com",
+				},
+			},
+		},
+	}
+
+	normalized := normalizeIngressV1(ingress)
+
+	assert := tassert.New(t)
+	assert.Equal("ingress-a", normalized.name)
+	assert.Equal("default", normalized.namespace)
+	assert.Equal("osm", normalized.class)
+	assert.Equal(map[string]string{useRegexAnnotation: "true"}, normalized.annotations)
+
+	assert.Equal(&normalizedBackend{serviceName: "default-backend", port: 8080}, normalized.defaultBackend)
+
+	assert.Equal([]normalizedTLS{{hosts: []string{"example.com"}, secretName: "example-com-cert"}}, normalized.tls)
+
+	assert.Len(normalized.rules, 1, "rule with no HTTP block should be skipped")
+	assert.Equal("example.com", normalized.rules[0].host)
+	assert.Equal([]normalizedPathMatch{
+		{path: "/healthz", pathType: networkingV1.PathTypeExact, backend: normalizedBackend{serviceName: "bookstore", port: 80}},
+	}, normalized.rules[0].paths)
+}
+
+func TestNormalizeIngressV1DefaultsPathTypeToImplementationSpecific(t *testing.T) {
+	ingress := &networkingV1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "ingress-b", Namespace: "default"},
+		Spec: networkingV1.IngressSpec{
+			Rules: []networkingV1.IngressRule{
+				{
+					Host: "example.com",
+					IngressRuleValue: networkingV1.IngressRuleValue{
+						HTTP: &networkingV1.HTTPIngressRuleValue{
+							Paths: []networkingV1.HTTPIngressPath{
+								{
+									// PathType intentionally left nil.
+									Path: "/foo",
+									Backend: networkingV1.IngressBackend{
+										Service: &networkingV1.IngressServiceBackend{
+											Name: "bookstore",
+											Port: networkingV1.ServiceBackendPort{Number: 80},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	normalized := normalizeIngressV1(ingress)
+
+	tassert.Equal(t, networkingV1.PathTypeImplementationSpecific, normalized.rules[0].paths[0].pathType)
+}
+
+func ptrTo(s string) *string {
+	return &s
+}
@@ -0,0 +1,145 @@
+package catalog
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	networkingV1 "k8s.io/api/networking/v1"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestBuildIngressHTTPRouteMatch(t *testing.T) {
+	testCases := []struct {
+		name          string
+		path          string
+		pathType      networkingV1.PathType
+		useRegex      bool
+		rewriteTarget string
+		expectOK      bool
+		expectedMatch trafficpolicy.HTTPRouteMatch
+	}{
+		{
+			name:     "Exact pathType matches the path as-is",
+			path:     "/healthz",
+			pathType: networkingV1.PathTypeExact,
+			expectOK: true,
+			expectedMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/healthz",
+				PathMatchType: trafficpolicy.PathMatchExact,
+				Methods:       []string{constants.WildcardHTTPMethod},
+			},
+		},
+		{
+			name:     "Prefix pathType with wildcard path '/' is a string prefix match",
+			path:     "/",
+			pathType: networkingV1.PathTypePrefix,
+			expectOK: true,
+			expectedMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/",
+				PathMatchType: trafficpolicy.PathMatchPrefix,
+				Methods:       []string{constants.WildcardHTTPMethod},
+			},
+		},
+		{
+			name:     "Prefix pathType with non-wildcard path becomes an element-wise regex match",
+			path:     "/foo",
+			pathType: networkingV1.PathTypePrefix,
+			expectOK: true,
+			expectedMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/foo" + prefixMatchPathElementsRegex,
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Methods:       []string{constants.WildcardHTTPMethod},
+			},
+		},
+		{
+			name:     "Prefix pathType trims a trailing slash before building the regex",
+			path:     "/foo/",
+			pathType: networkingV1.PathTypePrefix,
+			expectOK: true,
+			expectedMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/foo" + prefixMatchPathElementsRegex,
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Methods:       []string{constants.WildcardHTTPMethod},
+			},
+		},
+		{
+			name:     "ImplementationSpecific pathType with regex-like characters uses regex matching",
+			path:     "/foo.*",
+			pathType: networkingV1.PathTypeImplementationSpecific,
+			expectOK: true,
+			expectedMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/foo.*",
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Methods:       []string{constants.WildcardHTTPMethod},
+			},
+		},
+		{
+			name:     "ImplementationSpecific pathType without regex-like characters uses prefix matching",
+			path:     "/foo",
+			pathType: networkingV1.PathTypeImplementationSpecific,
+			expectOK: true,
+			expectedMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/foo",
+				PathMatchType: trafficpolicy.PathMatchPrefix,
+				Methods:       []string{constants.WildcardHTTPMethod},
+			},
+		},
+		{
+			name:     "use-regex annotation overrides PathType entirely",
+			path:     "/foo",
+			pathType: networkingV1.PathTypeExact,
+			useRegex: true,
+			expectOK: true,
+			expectedMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/foo",
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Methods:       []string{constants.WildcardHTTPMethod},
+			},
+		},
+		{
+			name:          "rewrite-target annotation is applied for non-Exact matches",
+			path:          "/foo",
+			pathType:      networkingV1.PathTypePrefix,
+			rewriteTarget: "/bar",
+			expectOK:      true,
+			expectedMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/foo" + prefixMatchPathElementsRegex,
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Methods:       []string{constants.WildcardHTTPMethod},
+				RewriteTarget: "/bar",
+			},
+		},
+		{
+			name:          "rewrite-target annotation is ignored for Exact matches",
+			path:          "/foo",
+			pathType:      networkingV1.PathTypeExact,
+			rewriteTarget: "/bar",
+			expectOK:      true,
+			expectedMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/foo",
+				PathMatchType: trafficpolicy.PathMatchExact,
+				Methods:       []string{constants.WildcardHTTPMethod},
+			},
+		},
+		{
+			name:     "invalid pathType is rejected",
+			path:     "/foo",
+			pathType: networkingV1.PathType("bogus"),
+			expectOK: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			match, ok := buildIngressHTTPRouteMatch(tc.path, tc.pathType, tc.useRegex, tc.rewriteTarget, "default", "ingress-a")
+			assert.Equal(tc.expectOK, ok)
+			if tc.expectOK {
+				assert.Equal(tc.expectedMatch, match)
+			}
+		})
+	}
+}
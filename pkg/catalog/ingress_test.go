@@ -0,0 +1,89 @@
+package catalog
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// newTestIngressPolicy builds a single-rule InboundTrafficPolicy the same way the ingress policy
+// builders in this package do, so compareIngressRoutes/sortIngressPoliciesBySpecificity can be
+// exercised against realistic input.
+func newTestIngressPolicy(name string, hostnames []string, path string, pathMatchType trafficpolicy.PathMatchType) *trafficpolicy.InboundTrafficPolicy {
+	svc := service.MeshService{Name: "bookstore", Namespace: "default"}
+	httpRouteMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          path,
+		PathMatchType: pathMatchType,
+		Methods:       []string{constants.WildcardHTTPMethod},
+	}
+
+	policy := trafficpolicy.NewInboundTrafficPolicy(name, hostnames)
+	policy.AddRule(*trafficpolicy.NewRouteWeightedCluster(httpRouteMatch, []service.WeightedCluster{getDefaultWeightedClusterForService(svc)}), identity.WildcardServiceIdentity)
+	return policy
+}
+
+func TestCompareIngressRoutes(t *testing.T) {
+	assert := tassert.New(t)
+
+	exact := newTestIngressPolicy("ingress-a.ns|example.com", []string{"example.com"}, "/healthz", trafficpolicy.PathMatchExact)
+	wildcardPrefix := newTestIngressPolicy("ingress-b.ns|example.com", []string{"example.com"}, "/", trafficpolicy.PathMatchPrefix)
+
+	// An exact "/healthz" route from one Ingress object must sort before a "/" wildcard Prefix route
+	// from another, or Envoy -- which evaluates routes top to bottom -- would never reach the more
+	// specific match.
+	assert.True(compareIngressRoutes(exact.Rules[0], wildcardPrefix.Rules[0], exact, wildcardPrefix, nil))
+	assert.False(compareIngressRoutes(wildcardPrefix.Rules[0], exact.Rules[0], wildcardPrefix, exact, nil))
+}
+
+func TestCompareIngressRoutesTiebreakByIngressOrigin(t *testing.T) {
+	assert := tassert.New(t)
+
+	// Both rules tie on path type, path length, and method count, and belong to the same merged
+	// policy/host, as would happen after MergeInboundPolicies folds rules from two Ingress objects
+	// targeting the same host into one policy. Only the rule-origin map can break the tie.
+	policy := newTestIngressPolicy("ingress-a.ns|example.com", []string{"example.com"}, "/foo", trafficpolicy.PathMatchPrefix)
+	ruleA := policy.Rules[0]
+	ruleB := &trafficpolicy.Rule{Route: ruleA.Route}
+
+	origins := map[*trafficpolicy.Rule]ingressRuleOrigin{
+		ruleA: {namespace: "ns", name: "ingress-a"},
+		ruleB: {namespace: "ns", name: "ingress-b"},
+	}
+
+	assert.True(compareIngressRoutes(ruleA, ruleB, policy, policy, origins))
+	assert.False(compareIngressRoutes(ruleB, ruleA, policy, policy, origins))
+}
+
+func TestSortIngressPoliciesBySpecificity(t *testing.T) {
+	assert := tassert.New(t)
+
+	// Intentionally constructed out of order: the less specific wildcard Prefix route comes first.
+	wildcardPrefix := newTestIngressPolicy("ingress-b.ns|example.com", []string{"example.com"}, "/", trafficpolicy.PathMatchPrefix)
+	exact := newTestIngressPolicy("ingress-a.ns|example.com", []string{"example.com"}, "/healthz", trafficpolicy.PathMatchExact)
+
+	policies := []*trafficpolicy.InboundTrafficPolicy{wildcardPrefix, exact}
+	sortIngressPoliciesBySpecificity(policies, nil)
+
+	assert.Equal(exact.Name, policies[0].Name)
+	assert.Equal(wildcardPrefix.Name, policies[1].Name)
+}
+
+func TestHostSpecificity(t *testing.T) {
+	testCases := []struct {
+		host     string
+		expected int
+	}{
+		{host: "example.com", expected: 0},
+		{host: "*.example.com", expected: 1},
+		{host: constants.WildcardHTTPMethod, expected: 2},
+	}
+
+	for _, tc := range testCases {
+		tassert.Equal(t, tc.expected, hostSpecificity(tc.host), "host=%s", tc.host)
+	}
+}